@@ -2,11 +2,10 @@ package goiso8601duration
 
 import (
 	"encoding/json"
-	"io"
+	"math"
 	"strconv"
-	"strings"
 	"time"
-	"unicode"
+	"unicode/utf8"
 )
 
 // This parser uses the examplary notion of allowing two numbers before any
@@ -27,16 +26,6 @@ const (
 // From is a FSM, see https://en.wikipedia.org/wiki/Finite-state_machine
 type state = uint8
 
-// In representations of duration,
-// the following designators are used as part of the expression,
-// see the doc comment of the function
-//
-// [Y] [M] [W] [D] [H] [M] [S]
-const (
-	defaultDesignators = "YMWD"
-	timeDesignators    = "MHS"
-)
-
 const (
 	stateStart state = iota
 	// start of duration: is used as duration designator, preceding the component which represents the duration;
@@ -44,6 +33,9 @@ const (
 
 	// seen n
 	stateNumber
+	// seen a [.] or [,] while in stateNumber, accumulating the decimal
+	// fraction of the smallest present component
+	stateNumberFraction
 	// seen [Y], [W], [M], [D]
 	stateDesignator
 
@@ -51,6 +43,9 @@ const (
 	stateT
 	// seen n
 	stateTNumber
+	// seen a [.] or [,] while in stateTNumber, accumulating the decimal
+	// fraction of the smallest present component
+	stateTNumberFraction
 	// seen [H], [M], [S]
 	stateTDesignator
 
@@ -95,7 +90,7 @@ func FromDuration(d time.Duration) Duration {
 	return duration
 }
 
-func numBufferToNumber(buf [maxNumCount]rune) int64 {
+func numBufferToNumber(buf [maxNumCount]byte) int64 {
 	var i int
 	for _, n := range buf {
 		if n == 0 { // empty number (zero byte) in buffer, stop
@@ -107,6 +102,10 @@ func numBufferToNumber(buf [maxNumCount]rune) int64 {
 	return int64(i)
 }
 
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
 // P[nn]Y[nn]M[nn]DT[nn]H[nn]M[nn]S or P[nn]W, as seen in
 // https://en.wikipedia.org/wiki/ISO_8601#Durations
 //
@@ -120,45 +119,54 @@ func numBufferToNumber(buf [maxNumCount]rune) int64 {
 //   - H is the hour designator that follows the value for the number of hours.
 //   - M is the minute designator that follows the value for the number of minutes.
 //   - S is the second designator that follows the value for the number of seconds.
+//
+// As per ISO8601 §4.4.3.2, the smallest present component may carry a
+// decimal fraction, using either [.] or [,] as the separator, e.g. PT0.5H
+// or P0,5Y. Using a fraction on any component other than the smallest
+// present one is rejected with [FractionNotOnSmallestComponent].
+//
+// From is a thin wrapper around [FromBytes] for callers holding a string;
+// prefer FromBytes on the hot path to avoid the string-to-[]byte copy.
 func From(s string) (Duration, error) {
+	return FromBytes([]byte(s))
+}
+
+// FromBytes is the primary implementation of the duration FSM described on
+// [From]. All valid input bytes are ASCII, so it scans b with a plain index
+// loop instead of decoding runes, which keeps parsing allocation-free.
+func FromBytes(b []byte) (Duration, error) {
 	var duration Duration
 
-	if len(s) == 0 {
+	if len(b) == 0 {
 		return duration, wrapErr(UnexpectedEof, 0)
 	}
 
 	curState := stateStart
 	var col uint8
 	var curNumCount uint8
-	var numBuf [maxNumCount]rune
+	var numBuf [maxNumCount]byte
+	var fracValue, fracDivisor float64
+	var fractionSeen bool
 
-	r := strings.NewReader(s)
+	idx := 0
 
 	for {
-		b, size, err := r.ReadRune()
-
-		// This is for debugging purposes
-		// var stateToName = map[state]string{
-		// 	stateStart:       "Start",
-		// 	stateP:           "P",
-		// 	stateWDesignator: "WDesignator",
-		// 	stateNumber:      "Number",
-		// 	stateT:           "T",
-		// 	stateTNumber:     "TNumber",
-		// 	stateTDesignator: "TDesignator",
-		// 	stateFin:         "Fin",
-		// }
-		// fmt.Printf("| rune=%c | col=%d | state=%s | buf=%v\n", b, col, stateToName[curState], numBuf)
-
-		if err != nil {
-			if err != io.EOF {
-				return duration, wrapErr(UnexpectedReaderError, col)
-			} else if curState == stateP {
-				// being in stateP at the end (io.EOF) means we havent
-				// encountered anything after the P, so there were no numbers
-				// or states
+		var ch byte
+		atEnd := idx >= len(b)
+		if !atEnd {
+			ch = b[idx]
+			if ch >= utf8.RuneSelf {
+				return duration, wrapErr(UnexpectedNonAsciiRune, col)
+			}
+		}
+
+		if atEnd {
+			if curState == stateP {
+				// being at the end in stateP means we havent encountered
+				// anything after the P, so there were no numbers or states
 				return duration, wrapErr(UnexpectedEof, col)
-			} else if curState == stateNumber || curState == stateTNumber {
+			} else if curState == stateNumber || curState == stateTNumber ||
+				curState == stateNumberFraction || curState == stateTNumberFraction {
 				// if we are in the state of Number or TNumber we had a number
 				// but no designator at the end
 				return duration, wrapErr(MissingDesignator, col)
@@ -166,14 +174,12 @@ func From(s string) (Duration, error) {
 				curState = stateFin
 			}
 		}
-		if size > 1 {
-			return duration, wrapErr(UnexpectedNonAsciiRune, col)
-		}
 		col++
+		idx++
 
 		switch curState {
 		case stateStart:
-			switch b {
+			switch ch {
 			case '-':
 				duration.hasNegativeSign = true
 				curState = stateStart
@@ -185,105 +191,192 @@ func From(s string) (Duration, error) {
 				return duration, wrapErr(MissingPDesignatorAtStart, col)
 			}
 		case stateP, stateDesignator:
-			if b == 'T' {
+			if ch == 'T' {
 				curState = stateT
-			} else if unicode.IsDigit(b) {
+			} else if isDigit(ch) {
+				if fractionSeen {
+					return duration, wrapErr(FractionNotOnSmallestComponent, col)
+				}
 				if curNumCount > maxNumCount {
 					return duration, wrapErr(TooManyNumbersForDesignator, col)
 				}
-				numBuf[curNumCount] = b
+				numBuf[curNumCount] = ch
 				curNumCount++
 				curState = stateNumber
 			} else {
 				return duration, wrapErr(MissingNumber, col)
 			}
 		case stateNumber:
-			if unicode.IsDigit(b) {
+			switch {
+			case isDigit(ch):
 				if curNumCount+1 > maxNumCount {
 					return duration, wrapErr(TooManyNumbersForDesignator, col)
 				}
-				numBuf[curNumCount] = b
+				numBuf[curNumCount] = ch
 				curNumCount++
 				curState = stateNumber
-			} else if strings.ContainsRune(defaultDesignators, b) {
+			case ch == '.' || ch == ',':
 				if curNumCount == 0 {
 					return duration, wrapErr(MissingNumber, col)
 				}
-				num := numBufferToNumber(numBuf)
-				switch b {
+				fracValue, fracDivisor = 0, 1
+				curState = stateNumberFraction
+			default:
+				if curNumCount == 0 {
+					return duration, wrapErr(MissingNumber, col)
+				}
+				num := float64(numBufferToNumber(numBuf))
+				switch ch {
 				case 'Y':
 					if duration.year != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.year = float64(num)
+					duration.year = num
 				case 'M':
 					if duration.month != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.month = float64(num)
+					duration.month = num
 				case 'W':
 					if duration.week != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.week = float64(num)
+					duration.week = num
 				case 'D':
 					if duration.day != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.day = float64(num)
+					duration.day = num
+				default:
+					return duration, wrapErr(UnknownDesignator, col)
 				}
 				curNumCount = 0
-				numBuf = [maxNumCount]rune{}
+				numBuf = [maxNumCount]byte{}
 				curState = stateDesignator
+			}
+		case stateNumberFraction:
+			if isDigit(ch) {
+				fracDivisor *= 10
+				fracValue += float64(ch-'0') / fracDivisor
+				curState = stateNumberFraction
 			} else {
-				return duration, wrapErr(UnknownDesignator, col)
+				value := float64(numBufferToNumber(numBuf)) + fracValue
+				switch ch {
+				case 'Y':
+					if duration.year != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.year = value
+				case 'M':
+					if duration.month != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.month = value
+				case 'W':
+					if duration.week != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.week = value
+				case 'D':
+					if duration.day != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.day = value
+				default:
+					return duration, wrapErr(UnknownDesignator, col)
+				}
+				curNumCount = 0
+				numBuf = [maxNumCount]byte{}
+				fractionSeen = true
+				curState = stateDesignator
 			}
 		case stateT, stateTDesignator:
-			if unicode.IsDigit(b) {
+			if isDigit(ch) {
+				if fractionSeen {
+					return duration, wrapErr(FractionNotOnSmallestComponent, col)
+				}
 				if curNumCount > maxNumCount {
 					return duration, wrapErr(TooManyNumbersForDesignator, col)
 				}
-				numBuf[curNumCount] = b
+				numBuf[curNumCount] = ch
 				curNumCount++
 				curState = stateTNumber
 			} else {
 				return duration, wrapErr(MissingNumber, col)
 			}
 		case stateTNumber:
-			if unicode.IsDigit(b) {
+			switch {
+			case isDigit(ch):
 				if curNumCount+1 > maxNumCount {
 					return duration, wrapErr(TooManyNumbersForDesignator, col)
 				}
-				numBuf[curNumCount] = b
+				numBuf[curNumCount] = ch
 				curNumCount++
 				curState = stateTNumber
-			} else if strings.ContainsRune(timeDesignators, b) {
+			case ch == '.' || ch == ',':
+				if curNumCount == 0 {
+					return duration, wrapErr(MissingNumber, col)
+				}
+				fracValue, fracDivisor = 0, 1
+				curState = stateTNumberFraction
+			default:
 				if curNumCount == 0 {
 					return duration, wrapErr(MissingNumber, col)
 				}
-				num := numBufferToNumber(numBuf)
-				switch b {
+				num := float64(numBufferToNumber(numBuf))
+				switch ch {
 				case 'H':
 					if duration.hour != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.hour = float64(num)
+					duration.hour = num
 				case 'M':
 					if duration.minute != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.minute = float64(num)
+					duration.minute = num
 				case 'S':
 					if duration.second != 0 {
 						return duration, wrapErr(DuplicateDesignator, col)
 					}
-					duration.second = float64(num)
+					duration.second = num
+				default:
+					return duration, wrapErr(UnknownDesignator, col)
 				}
 				curNumCount = 0
-				numBuf = [maxNumCount]rune{}
+				numBuf = [maxNumCount]byte{}
 				curState = stateTDesignator
+			}
+		case stateTNumberFraction:
+			if isDigit(ch) {
+				fracDivisor *= 10
+				fracValue += float64(ch-'0') / fracDivisor
+				curState = stateTNumberFraction
 			} else {
-				return duration, wrapErr(UnknownDesignator, col)
+				value := float64(numBufferToNumber(numBuf)) + fracValue
+				switch ch {
+				case 'H':
+					if duration.hour != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.hour = value
+				case 'M':
+					if duration.minute != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.minute = value
+				case 'S':
+					if duration.second != 0 {
+						return duration, wrapErr(DuplicateDesignator, col)
+					}
+					duration.second = value
+				default:
+					return duration, wrapErr(UnknownDesignator, col)
+				}
+				curNumCount = 0
+				numBuf = [maxNumCount]byte{}
+				fractionSeen = true
+				curState = stateTDesignator
 			}
 		case stateFin:
 			return duration, nil
@@ -322,63 +415,276 @@ func (i Duration) Duration() time.Duration {
 	return time.Duration(ns)
 }
 
-func (i Duration) String() string {
-	b := strings.Builder{}
+// signed returns v negated if the duration i is negative, v otherwise
+func (i Duration) signed(v float64) float64 {
 	if i.hasNegativeSign {
-		b.WriteByte('-')
+		return -v
+	}
+	return v
+}
+
+// normalize builds a Duration from signed field values (any field may be
+// negative) in year/month/day/hour/minute/second order. Week is folded into
+// day by callers beforehand, since Apply already treats day and week as the
+// same unit (i.day+i.week*7) and a separate negative week field would only
+// duplicate the day borrowing below.
+//
+// It first derives the result's sign from the fields' approximate
+// nanosecond total (the same daysPerYear/daysPerMonth approximation
+// Duration uses), then carries/borrows between adjacent units -
+// second<->minute<->hour<->day<->month<->year, using the exact relationships
+// 60/60/24 and the daysPerMonth/12-months-per-year pair Duration is built
+// from - so every field of the result ends up non-negative, consistent
+// with hasNegativeSign.
+func normalize(year, month, day, hour, minute, second float64) Duration {
+	total := year*daysPerYear*float64(nsPerDay) +
+		month*daysPerMonth*float64(nsPerDay) +
+		day*float64(nsPerDay) +
+		hour*float64(nsPerHour) +
+		minute*float64(nsPerMinute) +
+		second*float64(nsPerSecond)
+
+	neg := total < 0
+	if neg {
+		year, month, day, hour, minute, second = -year, -month, -day, -hour, -minute, -second
 	}
-	b.WriteByte('P')
+
+	if second < 0 {
+		borrow := math.Ceil(-second / 60)
+		second += borrow * 60
+		minute -= borrow
+	}
+	if minute < 0 {
+		borrow := math.Ceil(-minute / 60)
+		minute += borrow * 60
+		hour -= borrow
+	}
+	if hour < 0 {
+		borrow := math.Ceil(-hour / 24)
+		hour += borrow * 24
+		day -= borrow
+	}
+	if day < 0 {
+		borrow := math.Ceil(-day / daysPerMonth)
+		day += borrow * daysPerMonth
+		month -= borrow
+	}
+	if month < 0 {
+		borrow := math.Ceil(-month / 12)
+		month += borrow * 12
+		year -= borrow
+	}
+
+	// a borrow from a zero larger unit leaves it negative; hand that
+	// deficit back down to the next smaller unit until it is absorbed
+	if year < 0 {
+		month, year = month+year*12, 0
+	}
+	if month < 0 {
+		day, month = day+month*daysPerMonth, 0
+	}
+	if day < 0 {
+		hour, day = hour+day*24, 0
+	}
+	if hour < 0 {
+		minute, hour = minute+hour*60, 0
+	}
+	if minute < 0 {
+		second, minute = second+minute*60, 0
+	}
+
+	return Duration{
+		hasNegativeSign: neg,
+		year:            year,
+		month:           month,
+		day:             day,
+		hour:            hour,
+		minute:          minute,
+		second:          second,
+	}
+}
+
+// Add returns the field-wise sum of i and o.
+func (i Duration) Add(o Duration) Duration {
+	return normalize(
+		i.signed(i.year)+o.signed(o.year),
+		i.signed(i.month)+o.signed(o.month),
+		i.signed(i.day)+i.signed(i.week)*7+o.signed(o.day)+o.signed(o.week)*7,
+		i.signed(i.hour)+o.signed(o.hour),
+		i.signed(i.minute)+o.signed(o.minute),
+		i.signed(i.second)+o.signed(o.second),
+	)
+}
+
+// Sub returns the field-wise difference of i and o.
+func (i Duration) Sub(o Duration) Duration {
+	return i.Add(o.Neg())
+}
+
+// Neg returns i with its sign flipped. The zero Duration is its own negation.
+func (i Duration) Neg() Duration {
+	if i.IsZero() {
+		return i
+	}
+	i.hasNegativeSign = !i.hasNegativeSign
+	return i
+}
+
+// Scale returns i with every field multiplied by factor. A negative factor
+// flips the sign of the result.
+func (i Duration) Scale(factor float64) Duration {
+	return normalize(
+		i.signed(i.year)*factor,
+		i.signed(i.month)*factor,
+		(i.signed(i.day)+i.signed(i.week)*7)*factor,
+		i.signed(i.hour)*factor,
+		i.signed(i.minute)*factor,
+		i.signed(i.second)*factor,
+	)
+}
+
+// IsZero reports whether i represents a zero-length duration.
+func (i Duration) IsZero() bool {
+	return i.year == 0 && i.month == 0 && i.week == 0 && i.day == 0 &&
+		i.hour == 0 && i.minute == 0 && i.second == 0
+}
+
+// Compare returns -1, 0 or 1 depending on whether i is less than, equal to
+// or greater than o, using Duration() to establish a total order consistent
+// with time.Duration.
+func (i Duration) Compare(o Duration) int {
+	a, b := i.Duration(), o.Duration()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Between computes a calendar-aware Duration from from to to, decomposing
+// the difference into years, months, days and a remainder of hours, minutes
+// and seconds instead of the approximate daysPerYear-based nanosecond
+// conversion FromDuration uses, which loses accuracy over multi-year spans.
+func Between(from, to time.Time) Duration {
+	neg := to.Before(from)
+	if neg {
+		from, to = to, from
+	}
+
+	years := to.Year() - from.Year()
+	months := int(to.Month()) - int(from.Month())
+	days := to.Day() - from.Day()
+	hours := to.Hour() - from.Hour()
+	minutes := to.Minute() - from.Minute()
+	seconds := to.Second() - from.Second()
+	nanos := to.Nanosecond() - from.Nanosecond()
+
+	if nanos < 0 {
+		nanos += int(time.Second)
+		seconds--
+	}
+	if seconds < 0 {
+		seconds += 60
+		minutes--
+	}
+	if minutes < 0 {
+		minutes += 60
+		hours--
+	}
+	if hours < 0 {
+		hours += 24
+		days--
+	}
+	if days < 0 {
+		// the day before to's 1st is the last day of the preceding month
+		days += time.Date(to.Year(), to.Month(), 0, 0, 0, 0, 0, to.Location()).Day()
+		months--
+	}
+	if months < 0 {
+		months += 12
+		years--
+	}
+
+	return Duration{
+		hasNegativeSign: neg,
+		year:            float64(years),
+		month:           float64(months),
+		day:             float64(days),
+		hour:            float64(hours),
+		minute:          float64(minutes),
+		second:          float64(seconds) + float64(nanos)/float64(time.Second),
+	}
+}
+
+// AppendFormat appends the ISO8601 representation of i to dst and returns
+// the extended buffer, using strconv.AppendFloat directly to avoid the
+// intermediate string allocations strconv.FormatFloat would incur.
+func (i Duration) AppendFormat(dst []byte) []byte {
+	if i.hasNegativeSign {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, 'P')
 
 	// If the number of years, months, days, hours, minutes or seconds in any of these expressions equals
 	// zero, the number and the corresponding designator may be absent; however, at least one number
 	// and its designator shall be present
-	if i.year == 0 && i.month == 0 && i.week == 0 && i.day == 0 && i.hour == 0 && i.minute == 0 && i.second == 0 {
-		b.WriteString("0D")
-		return b.String()
+	if i.IsZero() {
+		return append(dst, '0', 'D')
 	}
 
 	if i.year > 0 {
-		b.WriteString(strconv.FormatFloat(i.year, 'g', -1, 64))
-		b.WriteByte('Y')
+		dst = strconv.AppendFloat(dst, i.year, 'f', -1, 64)
+		dst = append(dst, 'Y')
 	}
 	if i.month > 0 {
-		b.WriteString(strconv.FormatFloat(i.month, 'g', -1, 64))
-		b.WriteByte('M')
+		dst = strconv.AppendFloat(dst, i.month, 'f', -1, 64)
+		dst = append(dst, 'M')
 	}
 	if i.week > 0 {
-		b.WriteString(strconv.FormatFloat(i.week, 'g', -1, 64))
-		b.WriteByte('W')
+		dst = strconv.AppendFloat(dst, i.week, 'f', -1, 64)
+		dst = append(dst, 'W')
 	}
 	if i.day > 0 {
-		b.WriteString(strconv.FormatFloat(i.day, 'g', -1, 64))
-		b.WriteByte('D')
+		dst = strconv.AppendFloat(dst, i.day, 'f', -1, 64)
+		dst = append(dst, 'D')
 	}
 
 	// The designator [T] shall be absent if all of the time components are absent.
 	if i.hour > 0 || i.minute > 0 || i.second > 0 {
-		b.WriteByte('T')
+		dst = append(dst, 'T')
 
 		if i.hour > 0 {
-			b.WriteString(strconv.FormatFloat(i.hour, 'g', -1, 64))
-			b.WriteByte('H')
+			dst = strconv.AppendFloat(dst, i.hour, 'f', -1, 64)
+			dst = append(dst, 'H')
 		}
 
 		if i.minute > 0 {
-			b.WriteString(strconv.FormatFloat(i.minute, 'g', -1, 64))
-			b.WriteByte('M')
+			dst = strconv.AppendFloat(dst, i.minute, 'f', -1, 64)
+			dst = append(dst, 'M')
 		}
 
 		if i.second > 0 {
-			b.WriteString(strconv.FormatFloat(i.second, 'g', -1, 64))
-			b.WriteByte('S')
+			dst = strconv.AppendFloat(dst, i.second, 'f', -1, 64)
+			dst = append(dst, 'S')
 		}
 	}
 
-	return b.String()
+	return dst
+}
+
+func (i Duration) String() string {
+	return string(i.AppendFormat(make([]byte, 0, 32)))
 }
 
 func (i Duration) MarshalJSON() ([]byte, error) {
-	return json.Marshal(i.String())
+	dst := make([]byte, 0, 34)
+	dst = append(dst, '"')
+	dst = i.AppendFormat(dst)
+	dst = append(dst, '"')
+	return dst, nil
 }
 
 func (i *Duration) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,47 @@
+package goiso8601duration
+
+import "testing"
+
+func BenchmarkFromBytes(b *testing.B) {
+	input := []byte("P3Y6M4DT12H30M5S")
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		_, _ = FromBytes(input)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	d := Must(From("P3Y6M4DT12H30M5S"))
+	dst := make([]byte, 0, 32)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		dst = d.AppendFormat(dst[:0])
+	}
+}
+
+func TestFromBytesZeroAlloc(t *testing.T) {
+	input := []byte("P3Y6M4DT12H30M5S")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = FromBytes(input)
+	})
+	if allocs != 0 {
+		t.Fatalf("expected FromBytes to be allocation-free, got %v allocs/op", allocs)
+	}
+}
+
+func TestAppendFormatZeroAlloc(t *testing.T) {
+	d := Must(From("P3Y6M4DT12H30M5S"))
+	dst := make([]byte, 0, 32)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = d.AppendFormat(dst[:0])
+	})
+	if allocs != 0 {
+		t.Fatalf("expected AppendFormat to be allocation-free, got %v allocs/op", allocs)
+	}
+}
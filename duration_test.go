@@ -119,7 +119,7 @@ func TestDurationErr(t *testing.T) {
 	cases := []string{
 		"",        // UnexpectedEof
 		"P",       // UnexpectedEof
-		"Ã¨",       // UnexpectedNonAsciiRune
+		"Ã¨",      // UnexpectedNonAsciiRune
 		"P1",      // MissingDesignator
 		"P1A",     // UnknownDesignator
 		"P12D12D", // DuplicateDesignator
@@ -154,6 +154,111 @@ func TestJSONMarshalUnmarshal(t *testing.T) {
 	}
 }
 
+func TestDurationFraction(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+		dur Duration
+	}{
+		{"PT0.5S", "PT0.5S", Duration{second: 0.5}},
+		{"P0,5Y", "P0.5Y", Duration{year: 0.5}},
+		{"PT1.25H", "PT1.25H", Duration{hour: 1.25}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			parsed, err := From(tc.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.dur, parsed)
+			assert.Equal(t, tc.out, parsed.String())
+		})
+	}
+}
+
+func TestDurationFractionNotOnSmallestComponent(t *testing.T) {
+	cases := []string{
+		"P0.5YT1H",
+		"PT1.5H30M",
+	}
+
+	for _, i := range cases {
+		t.Run(i, func(t *testing.T) {
+			_, err := From(i)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDurationAddSub(t *testing.T) {
+	a := Must(From("P1Y2M"))
+	b := Must(From("P3M"))
+
+	assert.Equal(t, Must(From("P1Y5M")), a.Add(b))
+	assert.Equal(t, Must(From("P1Y5M")).Duration(), a.Add(b).Duration())
+
+	sum := Must(From("P1D")).Sub(Must(From("P2D")))
+	assert.True(t, sum.hasNegativeSign)
+	assert.Equal(t, float64(1), sum.day)
+
+	// 12M - 9M = 3M: the raw field-wise subtraction leaves month at -9
+	// before borrowing a year into 12 months, so this exercises the
+	// carry/borrow path in normalize rather than a same-signed addition.
+	borrowed := Must(From("P1Y")).Sub(Must(From("P9M")))
+	assert.False(t, borrowed.hasNegativeSign)
+	assert.Equal(t, float64(0), borrowed.year)
+	assert.Equal(t, float64(3), borrowed.month)
+	assert.Equal(t, "P3M", borrowed.String())
+	assert.Equal(t, Must(From("P1Y")).Duration()-Must(From("P9M")).Duration(), borrowed.Duration())
+}
+
+func TestDurationNeg(t *testing.T) {
+	d := Must(From("P1D"))
+	assert.Equal(t, "-P1D", d.Neg().String())
+	assert.Equal(t, "P1D", d.Neg().Neg().String())
+	assert.True(t, Duration{}.Neg().IsZero())
+}
+
+func TestDurationScale(t *testing.T) {
+	d := Must(From("P1DT2H"))
+	assert.Equal(t, Must(From("P2DT4H")), d.Scale(2))
+	assert.Equal(t, "-P2DT4H", d.Scale(-2).String())
+}
+
+func TestDurationIsZero(t *testing.T) {
+	assert.True(t, Duration{}.IsZero())
+	assert.False(t, Must(From("P1D")).IsZero())
+}
+
+func TestDurationCompare(t *testing.T) {
+	short := Must(From("PT1H"))
+	long := Must(From("PT2H"))
+
+	assert.Equal(t, -1, short.Compare(long))
+	assert.Equal(t, 1, long.Compare(short))
+	assert.Equal(t, 0, short.Compare(short))
+}
+
+func TestBetween(t *testing.T) {
+	from := time.Date(2020, time.January, 31, 10, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.March, 1, 12, 30, 15, 0, time.UTC)
+
+	d := Between(from, to)
+	assert.Equal(t, "P3Y T2H30M15S", normalizedDurationString(d))
+	assert.Equal(t, to, from.AddDate(int(d.year), int(d.month), int(d.day)).Add(
+		time.Duration(d.hour)*time.Hour+time.Duration(d.minute)*time.Minute+time.Duration(d.second)*time.Second,
+	))
+
+	reversed := Between(to, from)
+	assert.True(t, reversed.hasNegativeSign)
+}
+
+// normalizedDurationString renders the year and the time-of-day remainder of
+// a Duration for assertions that don't care about the exact month and day
+// counts.
+func normalizedDurationString(d Duration) string {
+	return fmt.Sprintf("P%vY T%vH%vM%vS", d.year, d.hour, d.minute, d.second)
+}
+
 func TestDurationRoundtrip(t *testing.T) {
 	for _, tc := range testcases {
 		t.Run(tc.str, func(t *testing.T) {
@@ -6,15 +6,24 @@ import (
 )
 
 var (
-	UnexpectedEof               = errors.New("Unexpected EOF in duration format string")
-	UnexpectedReaderError       = errors.New("Failed to retrieve next byte of duration format string")
-	UnexpectedNonAsciiRune      = errors.New("Unexpected non ascii component in duration format string")
-	MissingDesignator           = errors.New("Missing unit designator")
-	UnknownDesignator           = errors.New("Unknown designator, expected YMWD or after a T, HMS")
-	DuplicateDesignator         = errors.New("Duplicate designator")
-	MissingNumber               = errors.New("Missing number specifier before unit designator")
-	TooManyNumbersForDesignator = errors.New("Only 2 numbers before any designator allowed")
-	MissingPDesignatorAtStart   = errors.New("Missing [P] designator at the start of the duration format string")
+	UnexpectedEof                  = errors.New("Unexpected EOF in duration format string")
+	UnexpectedReaderError          = errors.New("Failed to retrieve next byte of duration format string")
+	UnexpectedNonAsciiRune         = errors.New("Unexpected non ascii component in duration format string")
+	MissingDesignator              = errors.New("Missing unit designator")
+	UnknownDesignator              = errors.New("Unknown designator, expected YMWD or after a T, HMS")
+	DuplicateDesignator            = errors.New("Duplicate designator")
+	MissingNumber                  = errors.New("Missing number specifier before unit designator")
+	TooManyNumbersForDesignator    = errors.New("Only 2 numbers before any designator allowed")
+	MissingPDesignatorAtStart      = errors.New("Missing [P] designator at the start of the duration format string")
+	FractionNotOnSmallestComponent = errors.New("A decimal fraction is only allowed on the smallest (last) present component")
+
+	MissingIntervalSeparator  = errors.New("Missing [/] separator in interval format string")
+	InvalidTimestamp          = errors.New("Failed to parse timestamp, expected RFC3339 or basic ISO8601 (20060102T150405Z) form")
+	InvalidInterval           = errors.New("Interval must be exactly one of start/end, start/duration or duration/end")
+	MissingRDesignatorAtStart = errors.New("Missing [R] designator at the start of the repeating interval format string")
+	InvalidRepeatCount        = errors.New("Invalid repeat count after [R] designator")
+
+	UnsupportedInICalendar = errors.New("RFC5545 durations cannot use [Y]/[M] calendar units, and [W] cannot be combined with other designators")
 )
 
 type ISO8601DurationError struct {
@@ -0,0 +1,107 @@
+package goiso8601duration
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FromICalendar parses s as an RFC5545 (iCalendar) DURATION value. This is a
+// restricted form of ISO8601 duration: it never uses the [Y]/[M] calendar
+// designators, and [W] cannot be combined with any other designator, see
+// https://www.rfc-editor.org/rfc/rfc5545#section-3.3.6
+func FromICalendar(s string) (Duration, error) {
+	d, err := From(s)
+	if err != nil {
+		return Duration{}, err
+	}
+
+	if err := d.validateICalendar(); err != nil {
+		return Duration{}, err
+	}
+
+	return d, nil
+}
+
+func (i Duration) validateICalendar() error {
+	if i.year != 0 || i.month != 0 {
+		return wrapErr(UnsupportedInICalendar, 0)
+	}
+	if i.week != 0 && (i.day != 0 || i.hour != 0 || i.minute != 0 || i.second != 0) {
+		return wrapErr(UnsupportedInICalendar, 0)
+	}
+	return nil
+}
+
+// ICalendarString formats i as an RFC5545 DURATION value, always emitting a
+// leading [+] or [-] sign designator. Like String, it trusts its fields and
+// does not validate them - a Duration built by From, Add, Scale, etc. can
+// carry [Y]/[M] or a combined [W], which have no RFC5545 rendering, so
+// callers that need the restriction enforced should check
+// validateICalendar (MarshalText does this for the ICalendar wrapper type).
+func (i Duration) ICalendarString() string {
+	b := strings.Builder{}
+	if i.hasNegativeSign {
+		b.WriteByte('-')
+	} else {
+		b.WriteByte('+')
+	}
+	b.WriteByte('P')
+
+	if i.week != 0 {
+		b.WriteString(strconv.FormatFloat(i.week, 'f', -1, 64))
+		b.WriteByte('W')
+		return b.String()
+	}
+
+	if i.day == 0 && i.hour == 0 && i.minute == 0 && i.second == 0 {
+		b.WriteString("0D")
+		return b.String()
+	}
+
+	if i.day > 0 {
+		b.WriteString(strconv.FormatFloat(i.day, 'f', -1, 64))
+		b.WriteByte('D')
+	}
+
+	if i.hour > 0 || i.minute > 0 || i.second > 0 {
+		b.WriteByte('T')
+
+		if i.hour > 0 {
+			b.WriteString(strconv.FormatFloat(i.hour, 'f', -1, 64))
+			b.WriteByte('H')
+		}
+		if i.minute > 0 {
+			b.WriteString(strconv.FormatFloat(i.minute, 'f', -1, 64))
+			b.WriteByte('M')
+		}
+		if i.second > 0 {
+			b.WriteString(strconv.FormatFloat(i.second, 'f', -1, 64))
+			b.WriteByte('S')
+		}
+	}
+
+	return b.String()
+}
+
+// ICalendar wraps Duration with MarshalText/UnmarshalText implementations
+// that speak the restricted RFC5545 DURATION form, for embedding into ICS
+// encoders without post-processing the format-correct output.
+type ICalendar struct {
+	Duration
+}
+
+func (c ICalendar) MarshalText() ([]byte, error) {
+	if err := c.Duration.validateICalendar(); err != nil {
+		return nil, err
+	}
+	return []byte(c.Duration.ICalendarString()), nil
+}
+
+func (c *ICalendar) UnmarshalText(text []byte) error {
+	d, err := FromICalendar(string(text))
+	if err != nil {
+		return err
+	}
+	c.Duration = d
+	return nil
+}
@@ -0,0 +1,64 @@
+package goiso8601duration
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromICalendar(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+		dur Duration
+	}{
+		{"P1DT2H3M4S", "+P1DT2H3M4S", Duration{day: 1, hour: 2, minute: 3, second: 4}},
+		{"-P1D", "-P1D", Duration{hasNegativeSign: true, day: 1}},
+		{"P5W", "+P5W", Duration{week: 5}},
+		{"PT1H", "+PT1H", Duration{hour: 1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			d, err := FromICalendar(tc.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.dur, d)
+
+			assert.Equal(t, tc.out, d.ICalendarString())
+		})
+	}
+}
+
+func TestFromICalendarUnsupported(t *testing.T) {
+	cases := []string{
+		"P1Y",
+		"P1M",
+		"P1W2D",
+		"P1WT1H",
+	}
+
+	for _, i := range cases {
+		t.Run(i, func(t *testing.T) {
+			_, err := FromICalendar(i)
+			assert.ErrorIs(t, err.(ISO8601DurationError).Inner, UnsupportedInICalendar)
+		})
+	}
+}
+
+func TestICalendarMarshalUnmarshalText(t *testing.T) {
+	var c ICalendar
+	assert.NoError(t, c.UnmarshalText([]byte("P1DT2H")))
+	assert.Equal(t, Duration{day: 1, hour: 2}, c.Duration)
+
+	var _ encoding.TextMarshaler = c
+	var _ encoding.TextUnmarshaler = &c
+
+	text, err := c.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "+P1DT2H", string(text))
+
+	bad := ICalendar{Duration: Duration{year: 1}}
+	_, err = bad.MarshalText()
+	assert.Error(t, err)
+}
@@ -0,0 +1,246 @@
+package goiso8601duration
+
+import (
+	"encoding/json"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// basicTimestampForm is the basic (non-extended) ISO8601 complete
+// representation of a timestamp, as opposed to time.RFC3339 which is the
+// extended form with [-] and [:] separators
+const basicTimestampForm = "20060102T150405Z"
+
+// IntervalKind describes which two of the three components (start,
+// end, duration) of an ISO8601 time interval are present, see
+// https://en.wikipedia.org/wiki/ISO_8601#Time_intervals
+type IntervalKind uint8
+
+const (
+	// StartEnd is a "start/end" interval, e.g. 2007-03-01T13:00:00Z/2008-05-11T15:30:00Z
+	StartEnd IntervalKind = iota
+	// StartDuration is a "start/duration" interval, e.g. 2007-03-01T13:00:00Z/P1Y2M10DT2H30M
+	StartDuration
+	// DurationEnd is a "duration/end" interval, e.g. P1Y2M10DT2H30M/2008-05-11T15:30:00Z
+	DurationEnd
+)
+
+// Interval is an ISO8601 time interval, expressed as any two of start,
+// end and duration, see https://en.wikipedia.org/wiki/ISO_8601#Time_intervals
+type Interval struct {
+	Kind     IntervalKind
+	Start    time.Time
+	End      time.Time
+	Duration Duration
+}
+
+// Repeating is an ISO8601 repeating interval ("Rn/..."), see
+// https://en.wikipedia.org/wiki/ISO_8601#Repeating_intervals
+type Repeating struct {
+	// Repeats is the number of times Interval repeats, -1 means unbounded ("R/...")
+	Repeats  int
+	Interval Interval
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(basicTimestampForm, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, wrapErr(InvalidTimestamp, 0)
+}
+
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func looksLikeDuration(s string) bool {
+	return strings.HasPrefix(s, "P") || strings.HasPrefix(s, "+P") || strings.HasPrefix(s, "-P")
+}
+
+// ParseInterval parses an ISO8601 time interval of the form "start/end",
+// "start/duration" or "duration/end".
+func ParseInterval(s string) (Interval, error) {
+	left, right, ok := strings.Cut(s, "/")
+	if !ok {
+		return Interval{}, wrapErr(MissingIntervalSeparator, 0)
+	}
+
+	leftIsDuration, rightIsDuration := looksLikeDuration(left), looksLikeDuration(right)
+
+	switch {
+	case !leftIsDuration && !rightIsDuration:
+		start, err := parseTimestamp(left)
+		if err != nil {
+			return Interval{}, err
+		}
+		end, err := parseTimestamp(right)
+		if err != nil {
+			return Interval{}, err
+		}
+		return Interval{Kind: StartEnd, Start: start, End: end}, nil
+	case !leftIsDuration && rightIsDuration:
+		start, err := parseTimestamp(left)
+		if err != nil {
+			return Interval{}, err
+		}
+		dur, err := From(right)
+		if err != nil {
+			return Interval{}, err
+		}
+		return Interval{Kind: StartDuration, Start: start, Duration: dur}, nil
+	case leftIsDuration && !rightIsDuration:
+		dur, err := From(left)
+		if err != nil {
+			return Interval{}, err
+		}
+		end, err := parseTimestamp(right)
+		if err != nil {
+			return Interval{}, err
+		}
+		return Interval{Kind: DurationEnd, Duration: dur, End: end}, nil
+	default:
+		return Interval{}, wrapErr(InvalidInterval, 0)
+	}
+}
+
+func (iv Interval) String() string {
+	switch iv.Kind {
+	case StartDuration:
+		return formatTimestamp(iv.Start) + "/" + iv.Duration.String()
+	case DurationEnd:
+		return iv.Duration.String() + "/" + formatTimestamp(iv.End)
+	default:
+		return formatTimestamp(iv.Start) + "/" + formatTimestamp(iv.End)
+	}
+}
+
+func (iv Interval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(iv.String())
+}
+
+func (iv *Interval) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	*iv = parsed
+
+	return nil
+}
+
+// start returns the first instant of iv, computing it from End and
+// Duration for a DurationEnd interval as the calendar-aware inverse of
+// Duration.Apply (AddDate for year/month/day, then the time-of-day
+// remainder), not the lossy nanosecond approximation Duration() gives for
+// [Y]/[M] fields.
+func (iv Interval) start() time.Time {
+	if iv.Kind == DurationEnd {
+		d := iv.Duration
+		timePortion := time.Duration(
+			(d.hour * float64(time.Hour)) +
+				(d.minute * float64(time.Minute)) +
+				(d.second * float64(time.Second)),
+		)
+		if d.hasNegativeSign {
+			timePortion = -timePortion
+		}
+		return iv.End.
+			AddDate(-int(d.year), -int(d.month), -int(d.day+d.week*7)).
+			Add(-timePortion)
+	}
+	return iv.Start
+}
+
+// ParseRepeating parses an ISO8601 repeating interval of the form
+// "Rn/start/end", "Rn/start/duration" or "Rn/duration/end". A missing n
+// (bare "R/...") means the interval repeats an unbounded number of times.
+func ParseRepeating(s string) (Repeating, error) {
+	if !strings.HasPrefix(s, "R") {
+		return Repeating{}, wrapErr(MissingRDesignatorAtStart, 0)
+	}
+
+	rest := s[1:]
+	countStr, intervalStr, ok := strings.Cut(rest, "/")
+	if !ok {
+		return Repeating{}, wrapErr(MissingIntervalSeparator, 0)
+	}
+
+	repeats := -1
+	if countStr != "" {
+		n, err := strconv.Atoi(countStr)
+		if err != nil {
+			return Repeating{}, wrapErr(InvalidRepeatCount, 0)
+		}
+		repeats = n
+	}
+
+	interval, err := ParseInterval(intervalStr)
+	if err != nil {
+		return Repeating{}, err
+	}
+
+	return Repeating{Repeats: repeats, Interval: interval}, nil
+}
+
+func (r Repeating) String() string {
+	b := strings.Builder{}
+	b.WriteByte('R')
+	if r.Repeats >= 0 {
+		b.WriteString(strconv.Itoa(r.Repeats))
+	}
+	b.WriteByte('/')
+	b.WriteString(r.Interval.String())
+	return b.String()
+}
+
+func (r Repeating) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Repeating) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := ParseRepeating(s)
+	if err != nil {
+		return err
+	}
+	*r = parsed
+
+	return nil
+}
+
+// Occurrences yields the start instants of r, beginning at r.Interval's
+// start and repeatedly advancing by r.Interval's duration (calendar-aware
+// via Duration.Apply, or the fixed End-Start gap for a StartEnd interval).
+// It yields r.Repeats+1 instants, the start plus one per repetition, unless
+// r.Repeats is negative (unbounded).
+func (r Repeating) Occurrences() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		cur := r.Interval.start()
+		gap := r.Interval.End.Sub(r.Interval.Start)
+
+		for i := 0; r.Repeats < 0 || i <= r.Repeats; i++ {
+			if !yield(cur) {
+				return
+			}
+			if r.Interval.Kind == StartEnd {
+				cur = cur.Add(gap)
+			} else {
+				cur = r.Interval.Duration.Apply(cur)
+			}
+		}
+	}
+}
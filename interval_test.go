@@ -0,0 +1,107 @@
+package goiso8601duration
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var intervalTestcases = []struct {
+	str string
+	iv  Interval
+}{
+	{
+		"2007-03-01T13:00:00Z/2008-05-11T15:30:00Z",
+		Interval{
+			Kind:  StartEnd,
+			Start: time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+			End:   time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+		},
+	},
+	{
+		"2007-03-01T13:00:00Z/P1Y2M10DT2H30M",
+		Interval{
+			Kind:     StartDuration,
+			Start:    time.Date(2007, 3, 1, 13, 0, 0, 0, time.UTC),
+			Duration: Duration{year: 1, month: 2, day: 10, hour: 2, minute: 30},
+		},
+	},
+	{
+		"P1Y2M10DT2H30M/2008-05-11T15:30:00Z",
+		Interval{
+			Kind:     DurationEnd,
+			Duration: Duration{year: 1, month: 2, day: 10, hour: 2, minute: 30},
+			End:      time.Date(2008, 5, 11, 15, 30, 0, 0, time.UTC),
+		},
+	},
+}
+
+func TestParseInterval(t *testing.T) {
+	for _, tc := range intervalTestcases {
+		t.Run(tc.str, func(t *testing.T) {
+			parsed, err := ParseInterval(tc.str)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.iv, parsed)
+			assert.Equal(t, tc.str, parsed.String())
+		})
+	}
+}
+
+func TestParseIntervalErr(t *testing.T) {
+	cases := []string{
+		"",
+		"2007-03-01T13:00:00Z",
+		"not-a-timestamp/P1D",
+		"P1D/not-a-timestamp",
+	}
+
+	for _, i := range cases {
+		t.Run(i, func(t *testing.T) {
+			_, err := ParseInterval(i)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseRepeating(t *testing.T) {
+	r, err := ParseRepeating("R5/2007-03-01T13:00:00Z/P1Y2M10DT2H30M")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, r.Repeats)
+	assert.Equal(t, "R5/2007-03-01T13:00:00Z/P1Y2M10DT2H30M", r.String())
+
+	unbounded, err := ParseRepeating("R/2007-03-01T13:00:00Z/P1D")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, unbounded.Repeats)
+	assert.Equal(t, "R/2007-03-01T13:00:00Z/P1D", unbounded.String())
+}
+
+func TestRepeatingOccurrences(t *testing.T) {
+	r := Must(ParseRepeating("R2/2007-03-01T00:00:00Z/P1D"))
+
+	var got []time.Time
+	for occ := range r.Occurrences() {
+		got = append(got, occ)
+	}
+
+	assert.Equal(t, []time.Time{
+		time.Date(2007, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2007, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2007, 3, 3, 0, 0, 0, 0, time.UTC),
+	}, got)
+}
+
+func TestIntervalJSONMarshalUnmarshal(t *testing.T) {
+	for _, tc := range intervalTestcases {
+		t.Run(tc.str, func(t *testing.T) {
+			data, err := json.Marshal(tc.iv)
+			assert.NoError(t, err)
+			assert.Equal(t, `"`+tc.str+`"`, string(data))
+
+			var unmarshaled Interval
+			assert.NoError(t, json.Unmarshal(data, &unmarshaled))
+			assert.Equal(t, tc.iv, unmarshaled)
+		})
+	}
+}